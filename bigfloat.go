@@ -0,0 +1,374 @@
+package math
+
+import (
+	stdmath "math"
+	"math/big"
+)
+
+// Context configures the precision and rounding mode used by BigFloat and
+// the *Ctx functions.
+type Context struct {
+	Prec     uint             // Mantissa precision in bits.
+	Rounding big.RoundingMode // Rounding mode applied to every operation.
+}
+
+// DefaultContext matches the precision of a float64, rounding to nearest-even.
+var DefaultContext = Context{Prec: 53, Rounding: big.ToNearestEven}
+
+// BigFloat is an arbitrary-precision floating point value backed by
+// math/big.Float, carrying the Context it was created with so that chained
+// operations stay at a consistent precision and rounding mode.
+type BigFloat struct {
+	v   *big.Float
+	ctx Context
+}
+
+// NewBigFloat converts x to a BigFloat at the precision and rounding mode
+// described by ctx.
+func NewBigFloat(x float64, ctx Context) BigFloat {
+	v := new(big.Float).SetPrec(ctx.ctxPrec()).SetMode(ctx.Rounding).SetFloat64(x)
+	return BigFloat{v: v, ctx: ctx}
+}
+
+// ctxPrec returns c.Prec, defaulting to DefaultContext.Prec when unset.
+func (c Context) ctxPrec() uint {
+	if c.Prec == 0 {
+		return DefaultContext.Prec
+	}
+	return c.Prec
+}
+
+// blank returns a zero-valued *big.Float at b's precision and rounding mode.
+func (b BigFloat) blank() *big.Float {
+	return new(big.Float).SetPrec(b.ctx.ctxPrec()).SetMode(b.ctx.Rounding)
+}
+
+// Float64 returns the nearest float64 to b.
+func (b BigFloat) Float64() float64 {
+	f, _ := b.v.Float64()
+	return f
+}
+
+// String returns b's decimal representation.
+func (b BigFloat) String() string {
+	return b.v.Text('g', 10)
+}
+
+// Add returns b + other.
+func (b BigFloat) Add(other BigFloat) BigFloat {
+	return BigFloat{v: b.blank().Add(b.v, other.v), ctx: b.ctx}
+}
+
+// Sub returns b - other.
+func (b BigFloat) Sub(other BigFloat) BigFloat {
+	return BigFloat{v: b.blank().Sub(b.v, other.v), ctx: b.ctx}
+}
+
+// Mul returns b * other.
+func (b BigFloat) Mul(other BigFloat) BigFloat {
+	return BigFloat{v: b.blank().Mul(b.v, other.v), ctx: b.ctx}
+}
+
+// Div returns b / other. Dividing a nonzero value by zero yields +/-Inf; it
+// panics when both b and other are zero or both are infinite, mirroring
+// big.Float.Quo.
+func (b BigFloat) Div(other BigFloat) BigFloat {
+	return BigFloat{v: b.blank().Quo(b.v, other.v), ctx: b.ctx}
+}
+
+// Sqrt returns the square root of b.
+func (b BigFloat) Sqrt() BigFloat {
+	return BigFloat{v: b.blank().Sqrt(b.v), ctx: b.ctx}
+}
+
+// Exp returns e**b, computed via a Taylor series around 0 with repeated
+// squaring to keep the series short for large |b|.
+func (b BigFloat) Exp() BigFloat {
+	prec := b.ctx.ctxPrec()
+	if b.v.IsInf() {
+		if b.v.Sign() > 0 {
+			return BigFloat{v: new(big.Float).SetPrec(prec).SetInf(false), ctx: b.ctx}
+		}
+		return BigFloat{v: new(big.Float).SetPrec(prec), ctx: b.ctx}
+	}
+	x := new(big.Float).SetPrec(prec).Set(b.v)
+
+	// Halve x until it's small enough for the series to converge quickly,
+	// then square the result that many times to undo the scaling:
+	// exp(x) = exp(x/2^k)^(2^k).
+	k := 0
+	two := new(big.Float).SetPrec(prec).SetFloat64(2)
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+	absX := new(big.Float).SetPrec(prec).Abs(x)
+	for absX.Cmp(one) > 0 {
+		x.Quo(x, two)
+		absX.Abs(x)
+		k++
+	}
+
+	sum := new(big.Float).SetPrec(prec).SetInt64(1)
+	term := new(big.Float).SetPrec(prec).SetInt64(1)
+	threshold := thresholdFor(prec)
+	for n := int64(1); n < 10000; n++ {
+		term.Mul(term, x)
+		term.Quo(term, new(big.Float).SetPrec(prec).SetInt64(n))
+		sum.Add(sum, term)
+		if new(big.Float).Abs(term).Cmp(threshold) < 0 {
+			break
+		}
+	}
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+	return BigFloat{v: sum.SetPrec(prec).SetMode(b.ctx.Rounding), ctx: b.ctx}
+}
+
+// Log returns the natural logarithm of b via Newton's method on f(y) = exp(y) - b.
+func (b BigFloat) Log() BigFloat {
+	if b.v.Sign() <= 0 {
+		panic("math: Log of non-positive BigFloat")
+	}
+	prec := b.ctx.ctxPrec()
+	// Seed the iteration from the float64 logarithm; Newton's method then
+	// refines it to the full precision of the context.
+	seed := stdmath.Log(b.Float64())
+	y := new(big.Float).SetPrec(prec).SetFloat64(seed)
+	for i := 0; i < 4*int(prec/32+1); i++ {
+		ey := BigFloat{v: y, ctx: b.ctx}.Exp()
+		// y_{n+1} = y_n + b/exp(y_n) - 1
+		delta := new(big.Float).SetPrec(prec).Quo(b.v, ey.v)
+		delta.Sub(delta, new(big.Float).SetPrec(prec).SetInt64(1))
+		y = new(big.Float).SetPrec(prec).Add(y, delta)
+	}
+	return BigFloat{v: y.SetMode(b.ctx.Rounding), ctx: b.ctx}
+}
+
+// Pow returns b raised to the power y, computed as Exp(y * Log(b)). It is
+// only valid for b > 0.
+func (b BigFloat) Pow(y BigFloat) BigFloat {
+	return b.Log().Mul(y).Exp()
+}
+
+// Sin returns the sine of b (in radians), computed via a Taylor series
+// after reducing b into [-Pi, Pi].
+func (b BigFloat) Sin() BigFloat {
+	prec := b.ctx.ctxPrec()
+	x := reduceAngle(b.v, prec)
+
+	sum := new(big.Float).SetPrec(prec)
+	term := new(big.Float).SetPrec(prec).Set(x)
+	sum.Add(sum, term)
+	xSq := new(big.Float).SetPrec(prec).Mul(x, x)
+	threshold := thresholdFor(prec)
+	for n := int64(1); n < 1000; n++ {
+		term.Mul(term, xSq)
+		term.Neg(term)
+		denom := new(big.Float).SetPrec(prec).SetInt64((2*n + 1) * (2 * n))
+		term.Quo(term, denom)
+		sum.Add(sum, term)
+		if new(big.Float).Abs(term).Cmp(threshold) < 0 {
+			break
+		}
+	}
+	return BigFloat{v: sum.SetMode(b.ctx.Rounding), ctx: b.ctx}
+}
+
+// Cos returns the cosine of b (in radians), computed as Sin(b + Pi/2).
+func (b BigFloat) Cos() BigFloat {
+	prec := b.ctx.ctxPrec()
+	halfPi := new(big.Float).SetPrec(prec).Quo(piAt(prec), new(big.Float).SetPrec(prec).SetInt64(2))
+	shifted := new(big.Float).SetPrec(prec).Add(b.v, halfPi)
+	return BigFloat{v: shifted, ctx: b.ctx}.Sin()
+}
+
+// thresholdFor returns the series-truncation threshold for a given precision:
+// roughly 2^-prec.
+func thresholdFor(prec uint) *big.Float {
+	t := new(big.Float).SetPrec(prec).SetInt64(1)
+	t.SetMantExp(t, -int(prec))
+	return t
+}
+
+// piAt returns Pi rounded to prec bits from a constant of about 100 decimal
+// digits (roughly 335 bits); at higher precisions the extra bits are not
+// accurate, and angle reduction loses accuracy accordingly.
+func piAt(prec uint) *big.Float {
+	const piDigits = "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798"
+	pi, _, _ := big.ParseFloat(piDigits, 10, prec, big.ToNearestEven)
+	return pi
+}
+
+// reduceAngle brings x into [-Pi, Pi] by subtracting the nearest multiple of 2*Pi.
+func reduceAngle(x *big.Float, prec uint) *big.Float {
+	pi := piAt(prec)
+	twoPi := new(big.Float).SetPrec(prec).Mul(pi, new(big.Float).SetPrec(prec).SetInt64(2))
+	k := new(big.Float).SetPrec(prec).Quo(x, twoPi)
+	kf, _ := k.Float64()
+	kRounded := new(big.Float).SetPrec(prec).SetInt64(int64(kf + sign(kf)*0.5))
+	reduced := new(big.Float).SetPrec(prec).Mul(kRounded, twoPi)
+	return new(big.Float).SetPrec(prec).Sub(x, reduced)
+}
+
+func sign(x float64) float64 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
+
+// AddCtx returns x + y rounded as specified by ctx, then converted to the
+// nearest float64.
+func AddCtx(x, y float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Add(NewBigFloat(y, ctx)).Float64()
+}
+
+// SubCtx returns x - y rounded as specified by ctx.
+func SubCtx(x, y float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Sub(NewBigFloat(y, ctx)).Float64()
+}
+
+// MulCtx returns x * y rounded as specified by ctx.
+func MulCtx(x, y float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Mul(NewBigFloat(y, ctx)).Float64()
+}
+
+// DivCtx returns x / y rounded as specified by ctx.
+func DivCtx(x, y float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Div(NewBigFloat(y, ctx)).Float64()
+}
+
+// SqrtCtx returns the square root of x rounded as specified by ctx.
+func SqrtCtx(x float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Sqrt().Float64()
+}
+
+// PowCtx returns x**y rounded as specified by ctx.
+func PowCtx(x, y float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Pow(NewBigFloat(y, ctx)).Float64()
+}
+
+// ExpCtx returns e**x rounded as specified by ctx.
+func ExpCtx(x float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Exp().Float64()
+}
+
+// LogCtx returns the natural logarithm of x rounded as specified by ctx.
+func LogCtx(x float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Log().Float64()
+}
+
+// SinCtx returns the sine of x (in radians) rounded as specified by ctx.
+func SinCtx(x float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Sin().Float64()
+}
+
+// CosCtx returns the cosine of x (in radians) rounded as specified by ctx.
+func CosCtx(x float64, ctx Context) float64 {
+	return NewBigFloat(x, ctx).Cos().Float64()
+}
+
+// Sum adds up x using Kahan-Neumaier compensated summation, which keeps a
+// running correction term to claw back precision lost to float64 rounding.
+// Unlike plain Kahan summation, it compares magnitudes on every term so it
+// stays accurate even when a later term is larger than the running sum.
+// It is a cheaper middle ground than Context-driven arbitrary precision for
+// the common case of summing a plain []float64-like slice.
+func Sum[N Number](x ...N) float64 {
+	var sum, c float64
+	for _, v := range x {
+		xv := float64(v)
+		t := sum + xv
+		if stdmath.Abs(sum) >= stdmath.Abs(xv) {
+			c += (sum - t) + xv
+		} else {
+			c += (xv - t) + sum
+		}
+		sum = t
+	}
+	return sum + c
+}
+
+// Dot returns the compensated dot product of x and y, using the same
+// Kahan-Neumaier summation as Sum.
+func Dot[N Number](x, y []N) (float64, error) {
+	if len(x) != len(y) {
+		return 0, ErrLengthMismatch
+	}
+	var sum, c float64
+	for i := range x {
+		p := float64(x[i]) * float64(y[i])
+		t := sum + p
+		if stdmath.Abs(sum) >= stdmath.Abs(p) {
+			c += (sum - t) + p
+		} else {
+			c += (p - t) + sum
+		}
+		sum = t
+	}
+	return sum + c, nil
+}
+
+// Scalar is implemented by both Float64Scalar and BigScalar, letting callers
+// write arithmetic generic over the backend: the cheap float64 path, or the
+// arbitrary-precision math/big path behind BigFloat. Mixing backends in a
+// single call (e.g. a Float64Scalar.Add(BigScalar{...})) panics, mirroring
+// how the concrete types are never implicitly convertible.
+type Scalar interface {
+	Add(Scalar) Scalar
+	Sub(Scalar) Scalar
+	Mul(Scalar) Scalar
+	Div(Scalar) Scalar
+	Sqrt() Scalar
+	Float64() float64
+}
+
+// Float64Scalar adapts a plain float64 to the Scalar interface, backing
+// Scalar-generic code with ordinary float64 arithmetic.
+type Float64Scalar float64
+
+// Add returns f + other.
+func (f Float64Scalar) Add(other Scalar) Scalar { return f + other.(Float64Scalar) }
+
+// Sub returns f - other.
+func (f Float64Scalar) Sub(other Scalar) Scalar { return f - other.(Float64Scalar) }
+
+// Mul returns f * other.
+func (f Float64Scalar) Mul(other Scalar) Scalar { return f * other.(Float64Scalar) }
+
+// Div returns f / other.
+func (f Float64Scalar) Div(other Scalar) Scalar { return f / other.(Float64Scalar) }
+
+// Sqrt returns the square root of f.
+func (f Float64Scalar) Sqrt() Scalar { return Float64Scalar(stdmath.Sqrt(float64(f))) }
+
+// Float64 returns f as a float64.
+func (f Float64Scalar) Float64() float64 { return float64(f) }
+
+// BigScalar adapts a BigFloat to the Scalar interface, backing Scalar-generic
+// code with arbitrary-precision math/big arithmetic.
+type BigScalar struct {
+	BigFloat
+}
+
+// Add returns b + other.
+func (b BigScalar) Add(other Scalar) Scalar {
+	return BigScalar{b.BigFloat.Add(other.(BigScalar).BigFloat)}
+}
+
+// Sub returns b - other.
+func (b BigScalar) Sub(other Scalar) Scalar {
+	return BigScalar{b.BigFloat.Sub(other.(BigScalar).BigFloat)}
+}
+
+// Mul returns b * other.
+func (b BigScalar) Mul(other Scalar) Scalar {
+	return BigScalar{b.BigFloat.Mul(other.(BigScalar).BigFloat)}
+}
+
+// Div returns b / other.
+func (b BigScalar) Div(other Scalar) Scalar {
+	return BigScalar{b.BigFloat.Div(other.(BigScalar).BigFloat)}
+}
+
+// Sqrt returns the square root of b.
+func (b BigScalar) Sqrt() Scalar { return BigScalar{b.BigFloat.Sqrt()} }
@@ -0,0 +1,79 @@
+package math
+
+import (
+	stdmath "math"
+	"testing"
+)
+
+func TestBigFloatExpLogRoundTrip(t *testing.T) {
+	ctx := Context{Prec: 200, Rounding: 0}
+	cases := []float64{0, 1, -1, 2.5, 10}
+	for _, x := range cases {
+		got := NewBigFloat(x, ctx).Exp().Log().Float64()
+		if !approxEqual(got, x, 1e-9) {
+			t.Errorf("Exp().Log() round trip for %v = %v", x, got)
+		}
+	}
+}
+
+func TestBigFloatExpMatchesStdlib(t *testing.T) {
+	ctx := DefaultContext
+	for _, x := range []float64{0, 1, -1, 3.75} {
+		got := NewBigFloat(x, ctx).Exp().Float64()
+		want := stdmath.Exp(x)
+		if !approxEqual(got, want, 1e-9) {
+			t.Errorf("Exp(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestBigFloatExpOnInfinity(t *testing.T) {
+	ctx := DefaultContext
+	if got := NewBigFloat(stdmath.Inf(1), ctx).Exp().Float64(); !stdmath.IsInf(got, 1) {
+		t.Fatalf("Exp(+Inf) = %v, want +Inf", got)
+	}
+	if got := NewBigFloat(stdmath.Inf(-1), ctx).Exp().Float64(); got != 0 {
+		t.Fatalf("Exp(-Inf) = %v, want 0", got)
+	}
+}
+
+func TestBigFloatLogNonPositivePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Log of a non-positive BigFloat did not panic")
+		}
+	}()
+	NewBigFloat(-1, DefaultContext).Log()
+}
+
+func TestBigFloatSinCos(t *testing.T) {
+	ctx := Context{Prec: 200, Rounding: 0}
+	for _, x := range []float64{0, 1, -2.3, 5} {
+		if got, want := NewBigFloat(x, ctx).Sin().Float64(), stdmath.Sin(x); !approxEqual(got, want, 1e-9) {
+			t.Errorf("Sin(%v) = %v, want %v", x, got, want)
+		}
+		if got, want := NewBigFloat(x, ctx).Cos().Float64(), stdmath.Cos(x); !approxEqual(got, want, 1e-9) {
+			t.Errorf("Cos(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func scalarQuadratic(a, b, c Scalar) Scalar {
+	return a.Mul(b).Add(c)
+}
+
+func TestScalarFloat64Backend(t *testing.T) {
+	got := scalarQuadratic(Float64Scalar(2), Float64Scalar(3), Float64Scalar(1)).Float64()
+	if want := 7.0; !approxEqual(got, want, 1e-9) {
+		t.Fatalf("scalarQuadratic(float64) = %v, want %v", got, want)
+	}
+}
+
+func TestScalarBigBackend(t *testing.T) {
+	ctx := DefaultContext
+	wrap := func(x float64) Scalar { return BigScalar{NewBigFloat(x, ctx)} }
+	got := scalarQuadratic(wrap(2), wrap(3), wrap(1)).Float64()
+	if want := 7.0; !approxEqual(got, want, 1e-9) {
+		t.Fatalf("scalarQuadratic(BigFloat) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,153 @@
+package math
+
+import stdmath "math"
+
+// EaseFn is a normalized easing curve: it maps t in [0,1] to an eased value,
+// typically also in [0,1].
+type EaseFn[N Float] func(t N) N
+
+// Tween blends a and b over t in [0,1], passing t through fn before handing
+// the eased factor to Lerp.
+func Tween[N Float](a, b N, t float64, fn EaseFn[N]) N {
+	eased := fn(N(t))
+	return Lerp(a, b, float64(eased))
+}
+
+// EaseInQuad accelerates from zero velocity.
+func EaseInQuad[N Float](t N) N {
+	return t * t
+}
+
+// EaseOutQuad decelerates to zero velocity.
+func EaseOutQuad[N Float](t N) N {
+	return t * (2 - t)
+}
+
+// EaseInOutCubic accelerates then decelerates using a cubic curve.
+func EaseInOutCubic[N Float](t N) N {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	f := -2*t + 2
+	return N(1 - stdmath.Pow(float64(f), 3)/2)
+}
+
+// EaseInQuint accelerates from zero velocity using a quintic curve.
+func EaseInQuint[N Float](t N) N {
+	return t * t * t * t * t
+}
+
+// EaseInOutSine eases in and out following a sine curve.
+func EaseInOutSine[N Float](t N) N {
+	return N(-(stdmath.Cos(stdmath.Pi*float64(t)) - 1) / 2)
+}
+
+// EaseInExpo accelerates from zero velocity using an exponential curve.
+func EaseInExpo[N Float](t N) N {
+	if t == 0 {
+		return 0
+	}
+	return N(stdmath.Pow(2, 10*float64(t)-10))
+}
+
+// EaseOutElastic overshoots past 1 before settling, like a plucked string.
+func EaseOutElastic[N Float](t N) N {
+	const c4 = 2 * stdmath.Pi / 3
+	if t == 0 {
+		return 0
+	}
+	if t == 1 {
+		return 1
+	}
+	ft := float64(t)
+	return N(stdmath.Pow(2, -10*ft)*stdmath.Sin((ft*10-0.75)*c4) + 1)
+}
+
+// EaseInOutBack overshoots slightly at both ends before settling.
+func EaseInOutBack[N Float](t N) N {
+	const c1 = 1.70158
+	const c2 = c1 * 1.525
+	ft := float64(t)
+	if ft < 0.5 {
+		return N((stdmath.Pow(2*ft, 2) * ((c2+1)*2*ft - c2)) / 2)
+	}
+	return N((stdmath.Pow(2*ft-2, 2)*((c2+1)*(ft*2-2)+c2) + 2) / 2)
+}
+
+// EaseOutBounce settles into place with a sequence of decreasing bounces.
+func EaseOutBounce[N Float](t N) N {
+	const n1 = 7.5625
+	const d1 = 2.75
+	ft := float64(t)
+	switch {
+	case ft < 1/d1:
+		return N(n1 * ft * ft)
+	case ft < 2/d1:
+		ft -= 1.5 / d1
+		return N(n1*ft*ft + 0.75)
+	case ft < 2.5/d1:
+		ft -= 2.25 / d1
+		return N(n1*ft*ft + 0.9375)
+	default:
+		ft -= 2.625 / d1
+		return N(n1*ft*ft + 0.984375)
+	}
+}
+
+// SmoothStep performs Hermite interpolation between 0 and 1, clamping t to
+// [0,1] first, the same clamping behavior Denormalize uses.
+func SmoothStep[N Float](t N) N {
+	t = Clamp(t, 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+// SmootherStep performs Perlin's improved Hermite interpolation
+// (6t^5-15t^4+10t^3) between 0 and 1, clamping t to [0,1] first.
+func SmootherStep[N Float](t N) N {
+	t = Clamp(t, 0, 1)
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// CatmullRom interpolates between p1 and p2 at parameter t in [0,1], using
+// p0 and p3 as the neighboring control points to shape the curve's tangents.
+func CatmullRom[N Float](p0, p1, p2, p3, t N) N {
+	tt := float64(t)
+	tt2 := tt * tt
+	tt3 := tt2 * tt
+	a, b, c, d := float64(p0), float64(p1), float64(p2), float64(p3)
+	return N(0.5 * ((2 * b) +
+		(-a+c)*tt +
+		(2*a-5*b+4*c-d)*tt2 +
+		(-a+3*b-3*c+d)*tt3))
+}
+
+// CubicBezier evaluates the cubic Bezier curve with control points
+// p0, p1, p2, p3 at parameter t in [0,1].
+func CubicBezier[N Float](p0, p1, p2, p3, t N) N {
+	u := 1 - t
+	return u*u*u*p0 + 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t*p3
+}
+
+// Bilinear interpolates a value on the unit square with corners q11, q21
+// (at y=0) and q12, q22 (at y=1), using tx to interpolate along x and ty to
+// interpolate along y.
+func Bilinear[N Float](q11, q12, q21, q22, tx, ty N) N {
+	top := Lerp(q11, q21, float64(tx))
+	bottom := Lerp(q12, q22, float64(tx))
+	return Lerp(top, bottom, float64(ty))
+}
+
+// Bicubic interpolates a value within a 4x4 neighborhood of samples using
+// Catmull-Rom splines along each axis, tx and ty both in [0,1]. neighborhood
+// must be a 4x4 Matrix or Bicubic returns ErrDimensionMismatch.
+func Bicubic(neighborhood Matrix, tx, ty float64) (float64, error) {
+	if neighborhood.Rows() != 4 || neighborhood.Cols() != 4 {
+		return 0, ErrDimensionMismatch
+	}
+	var rows [4]float64
+	for i := 0; i < 4; i++ {
+		row := neighborhood[i]
+		rows[i] = CatmullRom(row[0], row[1], row[2], row[3], tx)
+	}
+	return CatmullRom(rows[0], rows[1], rows[2], rows[3], ty), nil
+}
@@ -0,0 +1,131 @@
+package math
+
+import "testing"
+
+func TestEaseEndpoints(t *testing.T) {
+	fns := map[string]EaseFn[float64]{
+		"EaseInQuad":     EaseInQuad[float64],
+		"EaseOutQuad":    EaseOutQuad[float64],
+		"EaseInOutCubic": EaseInOutCubic[float64],
+		"EaseInQuint":    EaseInQuint[float64],
+		"EaseInOutSine":  EaseInOutSine[float64],
+		"EaseInExpo":     EaseInExpo[float64],
+		"EaseOutElastic": EaseOutElastic[float64],
+		"EaseInOutBack":  EaseInOutBack[float64],
+		"EaseOutBounce":  EaseOutBounce[float64],
+		"SmoothStep":     SmoothStep[float64],
+		"SmootherStep":   SmootherStep[float64],
+	}
+	for name, fn := range fns {
+		if got := fn(0); !approxEqual(got, 0, 1e-9) {
+			t.Errorf("%s(0) = %v, want 0", name, got)
+		}
+		if got := fn(1); !approxEqual(got, 1, 1e-9) {
+			t.Errorf("%s(1) = %v, want 1", name, got)
+		}
+	}
+}
+
+func TestEaseKnownMidpoints(t *testing.T) {
+	if got := EaseInQuad(0.5); !approxEqual(got, 0.25, 1e-9) {
+		t.Errorf("EaseInQuad(0.5) = %v, want 0.25", got)
+	}
+	if got := EaseOutQuad(0.5); !approxEqual(got, 0.75, 1e-9) {
+		t.Errorf("EaseOutQuad(0.5) = %v, want 0.75", got)
+	}
+	if got := EaseInOutSine(0.5); !approxEqual(got, 0.5, 1e-9) {
+		t.Errorf("EaseInOutSine(0.5) = %v, want 0.5", got)
+	}
+	if got := SmoothStep(0.5); !approxEqual(got, 0.5, 1e-9) {
+		t.Errorf("SmoothStep(0.5) = %v, want 0.5", got)
+	}
+	if got := SmootherStep(0.5); !approxEqual(got, 0.5, 1e-9) {
+		t.Errorf("SmootherStep(0.5) = %v, want 0.5", got)
+	}
+}
+
+func TestEaseMonotonic(t *testing.T) {
+	fns := map[string]EaseFn[float64]{
+		"EaseInQuad":     EaseInQuad[float64],
+		"EaseOutQuad":    EaseOutQuad[float64],
+		"EaseInOutCubic": EaseInOutCubic[float64],
+		"EaseInQuint":    EaseInQuint[float64],
+		"EaseInOutSine":  EaseInOutSine[float64],
+		"SmoothStep":     SmoothStep[float64],
+		"SmootherStep":   SmootherStep[float64],
+	}
+	const steps = 20
+	for name, fn := range fns {
+		prev := fn(0)
+		for i := 1; i <= steps; i++ {
+			frac := float64(i) / steps
+			cur := fn(frac)
+			if cur < prev-1e-9 {
+				t.Errorf("%s is not monotonic at t=%v: %v < %v", name, frac, cur, prev)
+			}
+			prev = cur
+		}
+	}
+}
+
+func TestSmoothStepClampsOutOfRange(t *testing.T) {
+	if got := SmoothStep(-1.0); !approxEqual(got, 0, 1e-9) {
+		t.Errorf("SmoothStep(-1) = %v, want 0", got)
+	}
+	if got := SmoothStep(2.0); !approxEqual(got, 1, 1e-9) {
+		t.Errorf("SmoothStep(2) = %v, want 1", got)
+	}
+}
+
+func TestTween(t *testing.T) {
+	got := Tween(0.0, 10.0, 0.5, EaseInOutSine[float64])
+	if !approxEqual(got, 5, 1e-9) {
+		t.Errorf("Tween(0, 10, 0.5, EaseInOutSine) = %v, want 5", got)
+	}
+}
+
+func TestCatmullRom(t *testing.T) {
+	if got := CatmullRom(0.0, 1.0, 2.0, 3.0, 0.0); !approxEqual(got, 1, 1e-9) {
+		t.Errorf("CatmullRom(t=0) = %v, want 1", got)
+	}
+	if got := CatmullRom(0.0, 1.0, 2.0, 3.0, 1.0); !approxEqual(got, 2, 1e-9) {
+		t.Errorf("CatmullRom(t=1) = %v, want 2", got)
+	}
+}
+
+func TestCubicBezier(t *testing.T) {
+	if got := CubicBezier(0.0, 1.0, 2.0, 3.0, 0.0); !approxEqual(got, 0, 1e-9) {
+		t.Errorf("CubicBezier(t=0) = %v, want 0", got)
+	}
+	if got := CubicBezier(0.0, 1.0, 2.0, 3.0, 1.0); !approxEqual(got, 3, 1e-9) {
+		t.Errorf("CubicBezier(t=1) = %v, want 3", got)
+	}
+}
+
+func TestBilinear(t *testing.T) {
+	got := Bilinear(0.0, 10.0, 20.0, 30.0, 0.5, 0.5)
+	if !approxEqual(got, 15, 1e-9) {
+		t.Errorf("Bilinear(midpoint) = %v, want 15", got)
+	}
+}
+
+func TestBicubic(t *testing.T) {
+	neighborhood := FromRows(
+		[]float64{1, 1, 1, 1},
+		[]float64{1, 1, 1, 1},
+		[]float64{1, 1, 1, 1},
+		[]float64{1, 1, 1, 1},
+	)
+	got, err := Bicubic(neighborhood, 0.3, 0.7)
+	if err != nil {
+		t.Fatalf("Bicubic on flat neighborhood returned error: %v", err)
+	}
+	if !approxEqual(got, 1, 1e-9) {
+		t.Errorf("Bicubic(flat) = %v, want 1", got)
+	}
+
+	wrongShape := FromRows([]float64{1, 2, 3})
+	if _, err := Bicubic(wrongShape, 0.5, 0.5); err != ErrDimensionMismatch {
+		t.Fatalf("Bicubic(wrong shape) error = %v, want ErrDimensionMismatch", err)
+	}
+}
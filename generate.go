@@ -4,11 +4,18 @@
 // generate.go
 //
 // Run with: go run generate.go
-// This generator scans the standard math package and produces:
-//   - core_functions.go
-//   - core_consts.go
-//   - core_vars.go
-//   - core_types.go
+// This generator scans a configurable list of standard library packages
+// (see scalarRules and bitsOps below) and produces, per package:
+//   - core[_<suffix>]_functions.go
+//   - core[_<suffix>]_consts.go
+//   - core[_<suffix>]_vars.go
+//   - core[_<suffix>]_types.go
+// The consts, vars and types files are only written when they have content;
+// a stale one from an earlier run is removed otherwise.
+//
+// math/bits is handled separately (see generateBitsFile) since its API is
+// split across fixed-width functions (OnesCount8/16/32/64, ...) rather than
+// a single float64-shaped signature that can be wrapped mechanically.
 
 package main
 
@@ -26,27 +33,30 @@ import (
 	"text/template"
 )
 
-// FuncInfo holds information about a math package function.
+// FuncInfo holds information about a wrapped package function.
 type FuncInfo struct {
-	Name          string // Function name.
+	Name          string // Exported name in this package (OrigName with rule.Prefix applied).
+	OrigName      string // Name as declared in the source package.
 	Params        string // Original (non‑generic) parameter list.
 	GenericParams string // Generated generic parameters (e.g. "x N, y N").
-	CastArgs      string // Arguments cast to float64 (e.g. "float64(x), float64(y)").
-	ReturnType    string // Return type (expected to be "float64" for generic wrappers).
-	IsGeneric     bool   // True if all parameters and the return type are float64 and there's only one return value.
+	CastArgs      string // Arguments cast to the scalar type (e.g. "float64(x), float64(y)").
+	ReturnType    string // Return type (expected to match the rule's scalar type for generic wrappers).
+	IsGeneric     bool   // True if all parameters and the return type match the rule's scalar type and there's only one return value.
 	OriginalSig   string // The original function signature (for reference).
 }
 
 // ConstInfo holds information about a constant.
 type ConstInfo struct {
-	Name  string
-	Value string
+	Name     string
+	OrigName string
+	Value    string
 }
 
 // VarInfo holds information about a variable.
 type VarInfo struct {
-	Name  string
-	Value string
+	Name     string
+	OrigName string
+	Value    string
 }
 
 // TypeInfo holds information about a type.
@@ -55,11 +65,45 @@ type TypeInfo struct {
 	Decl string
 }
 
+// scalarRule describes a standard library package whose exported functions
+// can be wrapped mechanically: any function all of whose parameters and
+// single return value are of ScalarType becomes a generic wrapper over
+// Constraint; everything else becomes a direct alias. Adding a new package
+// to this table is enough to generate wrappers for it — no code changes to
+// the scanning logic below are required.
+type scalarRule struct {
+	ImportPath string // e.g. "math", "math/cmplx", "math/rand/v2".
+	FilePrefix string // Output file prefix, e.g. "core", "core_cmplx".
+	ScalarType string // The concrete type the generic wrapper casts to/from.
+	Constraint string // The generic constraint used in the wrapper signature.
+	Prefix     string // Prepended to every exported function, constant and variable name to avoid collisions with other rules sharing this package; type declarations are copied unprefixed.
+	SkipTypes  bool   // Skip re-exporting the package's types. Needed for packages whose types embed or reference identifiers from their own package, which a verbatim copy into package math can't resolve.
+}
+
+var scalarRules = []scalarRule{
+	{ImportPath: "math", FilePrefix: "core", ScalarType: "float64", Constraint: "Number"},
+	{ImportPath: "math/cmplx", FilePrefix: "core_cmplx", ScalarType: "complex128", Constraint: "Complex", Prefix: "C"},
+	{ImportPath: "math/rand/v2", FilePrefix: "core_rand", ScalarType: "float64", Constraint: "Number", Prefix: "R", SkipTypes: true},
+}
+
 func main() {
-	// Locate the standard math package.
-	pkg, err := build.Import("math", "", 0)
+	for _, rule := range scalarRules {
+		generateScalarPackage(rule)
+	}
+	generateBitsFile()
+	log.Println("Core files generated successfully.")
+}
+
+// generateScalarPackage scans rule.ImportPath and emits its core files: the
+// functions file always, the consts, vars and types files only when non-empty.
+func generateScalarPackage(rule scalarRule) {
+	pkg, err := build.Import(rule.ImportPath, "", 0)
 	if err != nil {
-		log.Fatalf("failed to import math package: %v", err)
+		// A package unavailable on the running toolchain (e.g. math/rand/v2
+		// before go1.22) shouldn't abort generation for every other rule, so
+		// this one is skipped with a warning rather than fatal.
+		log.Printf("skipping %s: failed to import: %v", rule.ImportPath, err)
+		return
 	}
 
 	fset := token.NewFileSet()
@@ -68,7 +112,6 @@ func main() {
 	var vars []VarInfo
 	var types []TypeInfo
 
-	// Process each Go file in the math package.
 	for _, file := range pkg.GoFiles {
 		filePath := filepath.Join(pkg.Dir, file)
 		f, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
@@ -79,78 +122,12 @@ func main() {
 		for _, decl := range f.Decls {
 			switch d := decl.(type) {
 			case *ast.FuncDecl:
-				// Only process exported, top‑level functions.
-				if d.Name.IsExported() && d.Recv == nil {
-					isGeneric := true
-					var genericParams []string
-					var castArgs []string
-					var nonGenericParams []string
-
-					if d.Type.Params != nil {
-						for i, field := range d.Type.Params.List {
-							// For a generic wrapper we require the parameter to be a float64.
-							ident, ok := field.Type.(*ast.Ident)
-							if !ok || ident.Name != "float64" {
-								isGeneric = false
-							}
-							var names []string
-							if len(field.Names) == 0 {
-								// Generate a name if none is provided.
-								name := fmt.Sprintf("arg%d", i)
-								names = append(names, name)
-							} else {
-								for _, n := range field.Names {
-									names = append(names, n.Name)
-								}
-							}
-							// For the generic wrapper, use type N.
-							genericParams = append(genericParams, strings.Join(names, ", ")+" N")
-							// Cast each parameter to float64 for the call.
-							for _, n := range names {
-								castArgs = append(castArgs, "float64("+n+")")
-							}
-							// Build the original parameter string.
-							var typeBuf strings.Builder
-							printer.Fprint(&typeBuf, fset, field.Type)
-							nonGenericParams = append(nonGenericParams, strings.Join(names, ", ")+" "+typeBuf.String())
-						}
-					}
-					nonGenericParamsStr := strings.Join(nonGenericParams, ", ")
-					genericParamsStr := strings.Join(genericParams, ", ")
-					castArgsStr := strings.Join(castArgs, ", ")
-
-					// Process the result type.
-					retType := ""
-					if d.Type.Results != nil && len(d.Type.Results.List) == 1 {
-						// Check if the single Field has more than one name (i.e. multiple returns)
-						if d.Type.Results.List[0].Names != nil && len(d.Type.Results.List[0].Names) > 1 {
-							isGeneric = false
-						}
-						ident, ok := d.Type.Results.List[0].Type.(*ast.Ident)
-						if !ok || ident.Name != "float64" {
-							isGeneric = false
-						}
-						var retBuf strings.Builder
-						printer.Fprint(&retBuf, fset, d.Type.Results.List[0].Type)
-						retType = retBuf.String()
-					} else {
-						// Functions with zero or multiple return values are not made generic.
-						isGeneric = false
-					}
-
-					var sigBuf strings.Builder
-					printer.Fprint(&sigBuf, fset, d.Type)
-
-					fi := FuncInfo{
-						Name:          d.Name.Name,
-						Params:        nonGenericParamsStr,
-						GenericParams: genericParamsStr,
-						CastArgs:      castArgsStr,
-						ReturnType:    retType,
-						IsGeneric:     isGeneric,
-						OriginalSig:   sigBuf.String(),
-					}
-					funcs = append(funcs, fi)
+				// Declarations with their own type parameters (e.g. rand/v2's
+				// N[Int]) can't be wrapped by this scanner or aliased as a
+				// plain var without instantiation, so they're skipped rather
+				// than mis-scanned as ordinary functions.
+				if d.Name.IsExported() && d.Recv == nil && d.Type.TypeParams == nil {
+					funcs = append(funcs, scanFunc(fset, d, rule))
 				}
 			case *ast.GenDecl:
 				switch d.Tok {
@@ -163,10 +140,7 @@ func main() {
 								if i < len(vspec.Values) {
 									printer.Fprint(&valueBuf, fset, vspec.Values[i])
 								}
-								consts = append(consts, ConstInfo{
-									Name:  name.Name,
-									Value: valueBuf.String(),
-								})
+								consts = append(consts, ConstInfo{Name: rule.Prefix + name.Name, OrigName: name.Name, Value: valueBuf.String()})
 							}
 						}
 					}
@@ -179,23 +153,20 @@ func main() {
 								if i < len(vspec.Values) {
 									printer.Fprint(&valueBuf, fset, vspec.Values[i])
 								}
-								vars = append(vars, VarInfo{
-									Name:  name.Name,
-									Value: valueBuf.String(),
-								})
+								vars = append(vars, VarInfo{Name: rule.Prefix + name.Name, OrigName: name.Name, Value: valueBuf.String()})
 							}
 						}
 					}
 				case token.TYPE:
+					if rule.SkipTypes {
+						break
+					}
 					for _, spec := range d.Specs {
 						tspec := spec.(*ast.TypeSpec)
 						if tspec.Name.IsExported() {
 							var declBuf strings.Builder
 							printer.Fprint(&declBuf, fset, d)
-							types = append(types, TypeInfo{
-								Name: tspec.Name.Name,
-								Decl: declBuf.String(),
-							})
+							types = append(types, TypeInfo{Name: tspec.Name.Name, Decl: declBuf.String()})
 						}
 					}
 				}
@@ -203,101 +174,276 @@ func main() {
 		}
 	}
 
-	// Use a standard quoted string for the header.
-	const header = "// Code generated by go:generate; DO NOT EDIT.\n\npackage math\n"
+	writeFuncFile(rule, funcs)
+	writeConstFile(rule, consts)
+	writeVarFile(rule, vars)
+	writeTypeFile(rule, types)
+}
+
+// scanFunc classifies a single function declaration against rule, producing
+// the FuncInfo used by the function template.
+func scanFunc(fset *token.FileSet, d *ast.FuncDecl, rule scalarRule) FuncInfo {
+	isGeneric := true
+	var genericParams []string
+	var castArgs []string
+	var nonGenericParams []string
+
+	if d.Type.Params != nil {
+		for i, field := range d.Type.Params.List {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok || ident.Name != rule.ScalarType {
+				isGeneric = false
+			}
+			var names []string
+			if len(field.Names) == 0 {
+				names = append(names, fmt.Sprintf("arg%d", i))
+			} else {
+				for _, n := range field.Names {
+					names = append(names, n.Name)
+				}
+			}
+			genericParams = append(genericParams, strings.Join(names, ", ")+" N")
+			for _, n := range names {
+				castArgs = append(castArgs, rule.ScalarType+"("+n+")")
+			}
+			var typeBuf strings.Builder
+			printer.Fprint(&typeBuf, fset, field.Type)
+			nonGenericParams = append(nonGenericParams, strings.Join(names, ", ")+" "+typeBuf.String())
+		}
+	}
+
+	retType := ""
+	if d.Type.Results != nil && len(d.Type.Results.List) == 1 {
+		if d.Type.Results.List[0].Names != nil && len(d.Type.Results.List[0].Names) > 1 {
+			isGeneric = false
+		}
+		ident, ok := d.Type.Results.List[0].Type.(*ast.Ident)
+		if !ok || ident.Name != rule.ScalarType {
+			isGeneric = false
+		}
+		var retBuf strings.Builder
+		printer.Fprint(&retBuf, fset, d.Type.Results.List[0].Type)
+		retType = retBuf.String()
+	} else {
+		isGeneric = false
+	}
+
+	var sigBuf strings.Builder
+	printer.Fprint(&sigBuf, fset, d.Type)
 
-	// Generate core_functions.go
-	funcFile, err := os.Create("core_functions.go")
+	return FuncInfo{
+		Name:          rule.Prefix + d.Name.Name,
+		OrigName:      d.Name.Name,
+		Params:        strings.Join(nonGenericParams, ", "),
+		GenericParams: strings.Join(genericParams, ", "),
+		CastArgs:      strings.Join(castArgs, ", "),
+		ReturnType:    retType,
+		IsGeneric:     isGeneric,
+		OriginalSig:   sigBuf.String(),
+	}
+}
+
+// header returns the generated-file preamble for rule.
+func header(rule scalarRule) string {
+	return "// Code generated by go:generate; DO NOT EDIT.\n\npackage math\n"
+}
+
+func writeFuncFile(rule scalarRule, funcs []FuncInfo) {
+	path := rule.FilePrefix + "_functions.go"
+	f, err := os.Create(path)
 	if err != nil {
-		log.Fatalf("failed to create core_functions.go: %v", err)
+		log.Fatalf("failed to create %s: %v", path, err)
 	}
-	defer funcFile.Close()
+	defer f.Close()
 
-	funcTmplText := header + `
-import "math"
+	tmplText := header(rule) + `
+import "` + rule.ImportPath + `"
 
-// Core functions: wrappers for functions in the standard math package.
-{{range .}}
-// {{.Name}} {{if .IsGeneric}}wraps math.{{.Name}} in a generic function.{{else}}is a direct alias to math.{{.Name}}{{end}}.
+// Core functions: wrappers for functions in the standard ` + rule.ImportPath + ` package.
+{{$rule := .Rule}}
+{{range .Funcs}}
+// {{.Name}} {{if .IsGeneric}}wraps {{$rule.Pkg}}.{{.OrigName}} in a generic function.{{else}}is a direct alias to {{$rule.Pkg}}.{{.OrigName}}{{end}}.
 {{if .IsGeneric}}
-func {{.Name}}[N Number]({{.GenericParams}}) N {
-	return N(math.{{.Name}}({{.CastArgs}}))
+func {{.Name}}[N ` + rule.Constraint + `]({{.GenericParams}}) N {
+	return N({{$rule.Pkg}}.{{.OrigName}}({{.CastArgs}}))
 }
 {{else}}
 // Direct alias.
-var {{.Name}} = math.{{.Name}}
+var {{.Name}} = {{$rule.Pkg}}.{{.OrigName}}
 {{end}}
 {{end}}
 `
-	funcTmpl := template.Must(template.New("functions").Parse(funcTmplText))
-	if err := funcTmpl.Execute(funcFile, funcs); err != nil {
-		log.Fatalf("failed to execute template for core_functions.go: %v", err)
+	tmpl := template.Must(template.New("functions").Parse(tmplText))
+	data := struct {
+		Rule  struct{ Pkg string }
+		Funcs []FuncInfo
+	}{Funcs: funcs}
+	data.Rule.Pkg = pkgIdent(rule.ImportPath)
+	if err := tmpl.Execute(f, data); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", path, err)
 	}
+}
 
-	// Generate core_consts.go
-	constFile, err := os.Create("core_consts.go")
+// removeIfExists deletes a stale generated file from a previous run, e.g. one
+// whose content list has since become empty.
+func removeIfExists(path string) {
+	if _, err := os.Stat(path); err == nil {
+		os.Remove(path)
+	}
+}
+
+func writeConstFile(rule scalarRule, consts []ConstInfo) {
+	path := rule.FilePrefix + "_consts.go"
+	if len(consts) == 0 {
+		removeIfExists(path)
+		return
+	}
+	f, err := os.Create(path)
 	if err != nil {
-		log.Fatalf("failed to create core_consts.go: %v", err)
+		log.Fatalf("failed to create %s: %v", path, err)
 	}
-	defer constFile.Close()
+	defer f.Close()
 
-	constTmplText := header + `
-import "math"
+	pkg := pkgIdent(rule.ImportPath)
+	tmplText := header(rule) + `
+import "` + rule.ImportPath + `"
 
-// Core constants: re-exported from the standard math package.
+// Core constants: re-exported from the standard ` + rule.ImportPath + ` package.
 const (
-{{range .}}	{{.Name}} = math.{{.Name}}
+{{range .}}	{{.Name}} = ` + pkg + `.{{.OrigName}}
 {{end}})
 `
-	constTmpl := template.Must(template.New("consts").Parse(constTmplText))
-	if err := constTmpl.Execute(constFile, consts); err != nil {
-		log.Fatalf("failed to execute template for core_consts.go: %v", err)
+	tmpl := template.Must(template.New("consts").Parse(tmplText))
+	if err := tmpl.Execute(f, consts); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", path, err)
 	}
+}
 
-	// Generate core_vars.go
-	varFile, err := os.Create("core_vars.go")
+func writeVarFile(rule scalarRule, vars []VarInfo) {
+	path := rule.FilePrefix + "_vars.go"
+	if len(vars) == 0 {
+		removeIfExists(path)
+		return
+	}
+	f, err := os.Create(path)
 	if err != nil {
-		log.Fatalf("failed to create core_vars.go: %v", err)
+		log.Fatalf("failed to create %s: %v", path, err)
 	}
-	defer varFile.Close()
+	defer f.Close()
 
-	varTmplText := header + `
+	pkg := pkgIdent(rule.ImportPath)
+	tmplText := header(rule) + `
 import (
-	"math"
+	"` + rule.ImportPath + `"
 )
-// Core variables: re-exported from the standard math package.
+// Core variables: re-exported from the standard ` + rule.ImportPath + ` package.
 var (
-{{range .}}	{{.Name}} = math.{{.Name}}
+{{range .}}	{{.Name}} = ` + pkg + `.{{.OrigName}}
 {{end}})
-var _ = math.Pi // dummy usage to avoid unused import error.
 `
-	varTmpl := template.Must(template.New("vars").Parse(varTmplText))
-	if err := varTmpl.Execute(varFile, vars); err != nil {
-		log.Fatalf("failed to execute template for core_vars.go: %v", err)
+	tmpl := template.Must(template.New("vars").Parse(tmplText))
+	if err := tmpl.Execute(f, vars); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", path, err)
 	}
+}
 
-	// Generate core_types.go
-	typeFile, err := os.Create("core_types.go")
+func writeTypeFile(rule scalarRule, types []TypeInfo) {
+	path := rule.FilePrefix + "_types.go"
+	if len(types) == 0 {
+		removeIfExists(path)
+		return
+	}
+	f, err := os.Create(path)
 	if err != nil {
-		log.Fatalf("failed to create core_types.go: %v", err)
+		log.Fatalf("failed to create %s: %v", path, err)
 	}
-	defer typeFile.Close()
+	defer f.Close()
 
-	typeTmplText := header + `
-import (
-	"math"
-)
-// Core types: re-exported from the standard math package.
+	tmplText := header(rule) + `
+// Core types: re-exported from the standard ` + rule.ImportPath + ` package.
 {{range .}}
 {{.Decl}}
 
 {{end}}
-var _ = math.Pi // dummy usage to avoid unused import error.
 `
-	typeTmpl := template.Must(template.New("types").Parse(typeTmplText))
-	if err := typeTmpl.Execute(typeFile, types); err != nil {
-		log.Fatalf("failed to execute template for core_types.go: %v", err)
+	tmpl := template.Must(template.New("types").Parse(tmplText))
+	if err := tmpl.Execute(f, types); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", path, err)
 	}
+}
 
-	log.Println("Core files generated successfully.")
+// pkgIdent returns the identifier a package is referred to by once imported,
+// i.e. the last path element (math/rand/v2 -> rand).
+func pkgIdent(importPath string) string {
+	parts := strings.Split(importPath, "/")
+	last := parts[len(parts)-1]
+	// A major-version element such as "v2" is not the package name; the
+	// element before it is.
+	if len(parts) > 1 && len(last) > 1 && last[0] == 'v' && strings.Trim(last[1:], "0123456789") == "" {
+		return parts[len(parts)-2]
+	}
+	return last
+}
+
+// bitsOp describes one math/bits operation family (OnesCount, OnesCount8,
+// OnesCount16, ...) to be dispatched generically on the width of a BitsUint
+// type argument.
+type bitsOp struct {
+	Name        string // Base name, e.g. "OnesCount".
+	ExtraParams string // Extra parameter list beyond "x N", e.g. ", k int".
+	ExtraArgs   string // Extra argument list matching ExtraParams, e.g. ", k".
+	ReturnsN    bool   // True if the wrapper returns N (e.g. RotateLeft); false if it returns int.
+}
+
+var bitsOps = []bitsOp{
+	{Name: "OnesCount"},
+	{Name: "LeadingZeros"},
+	{Name: "TrailingZeros"},
+	{Name: "Len"},
+	{Name: "RotateLeft", ExtraParams: ", k int", ExtraArgs: ", k", ReturnsN: true},
+}
+
+// generateBitsFile emits core_bits_functions.go: generic wrappers around
+// math/bits that dispatch on the width of their BitsUint argument. Unlike
+// scalarRule packages, math/bits exposes one function per fixed width
+// (OnesCount8/16/32/64) rather than a single polymorphic signature, so the
+// wrappers are built from the bitsOps table instead of the AST scanner.
+func generateBitsFile() {
+	path := "core_bits_functions.go"
+	f, err := os.Create(path)
+	if err != nil {
+		log.Fatalf("failed to create %s: %v", path, err)
+	}
+	defer f.Close()
+
+	const tmplText = `// Code generated by go:generate; DO NOT EDIT.
+
+package math
+
+import "math/bits"
+
+// Core functions: generic, width-dispatching wrappers for math/bits.
+{{range .}}
+// {{.Name}} dispatches to the matching math/bits.{{.Name}}<width> function
+// based on the bit width of N.
+func {{.Name}}[N BitsUint](x N{{.ExtraParams}}) {{if .ReturnsN}}N{{else}}int{{end}} {
+	switch any(x).(type) {
+	case uint8:
+		return {{if .ReturnsN}}N({{end}}bits.{{.Name}}8(uint8(x){{.ExtraArgs}}){{if .ReturnsN}}){{end}}
+	case uint16:
+		return {{if .ReturnsN}}N({{end}}bits.{{.Name}}16(uint16(x){{.ExtraArgs}}){{if .ReturnsN}}){{end}}
+	case uint32:
+		return {{if .ReturnsN}}N({{end}}bits.{{.Name}}32(uint32(x){{.ExtraArgs}}){{if .ReturnsN}}){{end}}
+	case uint64:
+		return {{if .ReturnsN}}N({{end}}bits.{{.Name}}64(uint64(x){{.ExtraArgs}}){{if .ReturnsN}}){{end}}
+	default:
+		return {{if .ReturnsN}}N({{end}}bits.{{.Name}}(uint(x){{.ExtraArgs}}){{if .ReturnsN}}){{end}}
+	}
+}
+{{end}}
+`
+	tmpl := template.Must(template.New("bits").Parse(tmplText))
+	if err := tmpl.Execute(f, bitsOps); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", path, err)
+	}
 }
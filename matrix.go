@@ -0,0 +1,600 @@
+package math
+
+import (
+	"errors"
+	stdmath "math"
+)
+
+// Sentinel errors returned by the linear algebra subsystem.
+var (
+	ErrDimensionMismatch = errors.New("math: matrix dimensions do not match")
+	ErrNotSquare         = errors.New("math: matrix is not square")
+	ErrSingularMatrix    = errors.New("math: matrix is singular")
+	ErrNotSPD            = errors.New("math: matrix is not symmetric positive-definite")
+	ErrReshape           = errors.New("math: reshape target size does not match element count")
+)
+
+// NewMatrix allocates a rows x cols matrix with all entries set to zero.
+func NewMatrix(rows, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]float64, cols)
+	}
+	return m
+}
+
+// Zeros returns a rows x cols matrix with all entries set to zero.
+func Zeros(rows, cols int) Matrix {
+	return NewMatrix(rows, cols)
+}
+
+// Ones returns a rows x cols matrix with all entries set to one.
+func Ones(rows, cols int) Matrix {
+	m := NewMatrix(rows, cols)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = 1
+		}
+	}
+	return m
+}
+
+// Identity returns the n x n identity matrix.
+func Identity(n int) Matrix {
+	m := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// FromRows builds a Matrix from the given rows. It panics if the rows
+// are not all the same length, mirroring the zero-value semantics of Matrix.
+func FromRows(rows ...[]float64) Matrix {
+	m := make(Matrix, len(rows))
+	for i, row := range rows {
+		if i > 0 && len(row) != len(rows[0]) {
+			panic("math: FromRows rows have differing lengths")
+		}
+		r := make([]float64, len(row))
+		copy(r, row)
+		m[i] = r
+	}
+	return m
+}
+
+// Diag returns a square matrix with values on the main diagonal and zero elsewhere.
+func Diag(values ...float64) Matrix {
+	m := NewMatrix(len(values), len(values))
+	for i, v := range values {
+		m[i][i] = v
+	}
+	return m
+}
+
+// Rows returns the number of rows in m.
+func (m Matrix) Rows() int {
+	return len(m)
+}
+
+// Cols returns the number of columns in m, or 0 for an empty matrix.
+func (m Matrix) Cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// Transpose returns a new matrix with rows and columns swapped.
+func (m Matrix) Transpose() Matrix {
+	rows, cols := m.Rows(), m.Cols()
+	t := NewMatrix(cols, rows)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			t[j][i] = m[i][j]
+		}
+	}
+	return t
+}
+
+// Reshape returns a new matrix with the given dimensions, reading entries
+// from m in row-major order. It returns ErrReshape if rows*cols does not
+// match the number of entries in m.
+func (m Matrix) Reshape(rows, cols int) (Matrix, error) {
+	if rows*cols != m.Rows()*m.Cols() {
+		return nil, ErrReshape
+	}
+	flat := make([]float64, 0, rows*cols)
+	for _, row := range m {
+		flat = append(flat, row...)
+	}
+	out := NewMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		copy(out[i], flat[i*cols:(i+1)*cols])
+	}
+	return out, nil
+}
+
+// clone returns a deep copy of m.
+func (m Matrix) clone() Matrix {
+	out := make(Matrix, len(m))
+	for i, row := range m {
+		out[i] = append([]float64(nil), row...)
+	}
+	return out
+}
+
+// sameShape reports whether a and b have identical dimensions.
+func sameShape(a, b Matrix) bool {
+	return a.Rows() == b.Rows() && a.Cols() == b.Cols()
+}
+
+// AddM returns the elementwise sum of a and b.
+func AddM(a, b Matrix) (Matrix, error) {
+	if !sameShape(a, b) {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = a[i][j] + b[i][j]
+		}
+	}
+	return out, nil
+}
+
+// SubM returns the elementwise difference a - b.
+func SubM(a, b Matrix) (Matrix, error) {
+	if !sameShape(a, b) {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = a[i][j] - b[i][j]
+		}
+	}
+	return out, nil
+}
+
+// HadamardM returns the elementwise (Hadamard) product of a and b.
+func HadamardM(a, b Matrix) (Matrix, error) {
+	if !sameShape(a, b) {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = a[i][j] * b[i][j]
+		}
+	}
+	return out, nil
+}
+
+// ScaleM returns a copy of a with every entry multiplied by s.
+func ScaleM(a Matrix, s float64) Matrix {
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = a[i][j] * s
+		}
+	}
+	return out
+}
+
+// ClampEntries returns a copy of a with every entry restricted to [min, max].
+func ClampEntries(a Matrix, min, max float64) Matrix {
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = Clamp(a[i][j], min, max)
+		}
+	}
+	return out
+}
+
+// LerpM performs elementwise linear interpolation between a and b with weight t.
+func LerpM(a, b Matrix, t float64) (Matrix, error) {
+	if !sameShape(a, b) {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = Lerp(a[i][j], b[i][j], t)
+		}
+	}
+	return out, nil
+}
+
+// MulM returns the matrix product a * b.
+func MulM(a, b Matrix) (Matrix, error) {
+	if a.Cols() != b.Rows() {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrix(a.Rows(), b.Cols())
+	for i := 0; i < a.Rows(); i++ {
+		for k := 0; k < a.Cols(); k++ {
+			aik := a[i][k]
+			if aik == 0 {
+				continue
+			}
+			for j := 0; j < b.Cols(); j++ {
+				out[i][j] += aik * b[k][j]
+			}
+		}
+	}
+	return out, nil
+}
+
+// Trace returns the sum of the diagonal entries of a square matrix.
+func Trace(a Matrix) (float64, error) {
+	if a.Rows() != a.Cols() {
+		return 0, ErrNotSquare
+	}
+	var sum float64
+	for i := 0; i < a.Rows(); i++ {
+		sum += a[i][i]
+	}
+	return sum, nil
+}
+
+// FrobeniusNorm returns the Frobenius (Euclidean) norm of a.
+func FrobeniusNorm(a Matrix) float64 {
+	var sum float64
+	for _, row := range a {
+		for _, v := range row {
+			sum += v * v
+		}
+	}
+	return stdmath.Sqrt(sum)
+}
+
+// InfNorm returns the infinity norm of a: the largest absolute row sum.
+func InfNorm(a Matrix) float64 {
+	var maxSum float64
+	for _, row := range a {
+		var sum float64
+		for _, v := range row {
+			sum += stdmath.Abs(v)
+		}
+		maxSum = stdmath.Max(maxSum, sum)
+	}
+	return maxSum
+}
+
+// luDecompose computes the LU decomposition of square matrix a with partial
+// pivoting, returning L, U, a permutation matrix P such that P*a = L*U, and
+// the sign of the permutation (+1 or -1) for determinant bookkeeping.
+func luDecompose(a Matrix) (l, u, p Matrix, sign float64, err error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return nil, nil, nil, 0, ErrNotSquare
+	}
+	u = a.clone()
+	l = Identity(n)
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign = 1
+
+	for k := 0; k < n; k++ {
+		pivot := k
+		maxVal := stdmath.Abs(u[k][k])
+		for i := k + 1; i < n; i++ {
+			if v := stdmath.Abs(u[i][k]); v > maxVal {
+				maxVal, pivot = v, i
+			}
+		}
+		if maxVal == 0 {
+			return nil, nil, nil, 0, ErrSingularMatrix
+		}
+		if pivot != k {
+			u[k], u[pivot] = u[pivot], u[k]
+			perm[k], perm[pivot] = perm[pivot], perm[k]
+			for j := 0; j < k; j++ {
+				l[k][j], l[pivot][j] = l[pivot][j], l[k][j]
+			}
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			factor := u[i][k] / u[k][k]
+			l[i][k] = factor
+			for j := k; j < n; j++ {
+				u[i][j] -= factor * u[k][j]
+			}
+		}
+	}
+
+	p = NewMatrix(n, n)
+	for i, src := range perm {
+		p[i][src] = 1
+	}
+	return l, u, p, sign, nil
+}
+
+// LU computes the LU decomposition of square matrix a with partial pivoting,
+// returning L, U and a permutation matrix P such that P*a = L*U.
+func LU(a Matrix) (l, u, p Matrix, err error) {
+	l, u, p, _, err = luDecompose(a)
+	return l, u, p, err
+}
+
+// Determinant returns the determinant of a square matrix, computed via its
+// LU decomposition.
+func Determinant(a Matrix) (float64, error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return 0, ErrNotSquare
+	}
+	_, u, _, sign, err := luDecompose(a)
+	if err != nil {
+		if errors.Is(err, ErrSingularMatrix) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	det := sign
+	for i := 0; i < n; i++ {
+		det *= u[i][i]
+	}
+	return det, nil
+}
+
+// forwardSubst solves the lower-triangular system l*x = b.
+func forwardSubst(l Matrix, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for j := 0; j < i; j++ {
+			sum -= l[i][j] * x[j]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+// backwardSubst solves the upper-triangular system u*x = b.
+func backwardSubst(u Matrix, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := b[i]
+		for j := i + 1; j < n; j++ {
+			sum -= u[i][j] * x[j]
+		}
+		x[i] = sum / u[i][i]
+	}
+	return x
+}
+
+// Solve solves the linear system a*x = b for x via LU decomposition with
+// partial pivoting. b may have multiple columns, one system per column.
+func Solve(a, b Matrix) (Matrix, error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return nil, ErrNotSquare
+	}
+	if b.Rows() != n {
+		return nil, ErrDimensionMismatch
+	}
+	l, u, p, err := LU(a)
+	if err != nil {
+		return nil, err
+	}
+	x := NewMatrix(n, b.Cols())
+	for col := 0; col < b.Cols(); col++ {
+		pb := make([]float64, n)
+		for i := 0; i < n; i++ {
+			for k := 0; k < n; k++ {
+				if p[i][k] == 1 {
+					pb[i] = b[k][col]
+					break
+				}
+			}
+		}
+		y := forwardSubst(l, pb)
+		sol := backwardSubst(u, y)
+		for i := 0; i < n; i++ {
+			x[i][col] = sol[i]
+		}
+	}
+	return x, nil
+}
+
+// Inverse returns the inverse of a square matrix via LU-based Solve against
+// the identity matrix.
+func Inverse(a Matrix) (Matrix, error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return nil, ErrNotSquare
+	}
+	return Solve(a, Identity(n))
+}
+
+// QR computes the QR decomposition of a via Householder reflections, such
+// that a = Q*R with Q orthogonal and R upper triangular.
+func QR(a Matrix) (q, r Matrix, err error) {
+	m, n := a.Rows(), a.Cols()
+	if m == 0 || n == 0 {
+		return nil, nil, ErrDimensionMismatch
+	}
+	r = a.clone()
+	q = Identity(m)
+
+	for k := 0; k < n && k < m-1; k++ {
+		var normX float64
+		for i := k; i < m; i++ {
+			normX += r[i][k] * r[i][k]
+		}
+		normX = stdmath.Sqrt(normX)
+		if normX == 0 {
+			continue
+		}
+		if r[k][k] > 0 {
+			normX = -normX
+		}
+		v := make([]float64, m)
+		v[k] = r[k][k] - normX
+		for i := k + 1; i < m; i++ {
+			v[i] = r[i][k]
+		}
+		var vNorm float64
+		for i := k; i < m; i++ {
+			vNorm += v[i] * v[i]
+		}
+		if vNorm == 0 {
+			continue
+		}
+
+		// Apply the Householder reflector H = I - 2*v*v^T/vNorm to R and
+		// accumulate it into Q.
+		for j := 0; j < n; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += v[i] * r[i][j]
+			}
+			factor := 2 * dot / vNorm
+			for i := k; i < m; i++ {
+				r[i][j] -= factor * v[i]
+			}
+		}
+		for j := 0; j < m; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += v[i] * q[j][i]
+			}
+			factor := 2 * dot / vNorm
+			for i := k; i < m; i++ {
+				q[j][i] -= factor * v[i]
+			}
+		}
+	}
+	return q, r, nil
+}
+
+// Cholesky computes the lower-triangular Cholesky factor L of a symmetric
+// positive-definite matrix a, such that a = L*L^T.
+func Cholesky(a Matrix) (Matrix, error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return nil, ErrNotSquare
+	}
+	l := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, ErrNotSPD
+				}
+				l[i][j] = stdmath.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// SVD computes the singular value decomposition of a via one-sided Jacobi
+// rotation (not Golub-Reinsch bidiagonalization), returning U, a diagonal
+// matrix S of singular values, and V such that a = U*S*V^T.
+func SVD(a Matrix) (u, s, v Matrix, err error) {
+	m, n := a.Rows(), a.Cols()
+	if m == 0 || n == 0 {
+		return nil, nil, nil, ErrDimensionMismatch
+	}
+
+	// One-sided Jacobi SVD: simpler to implement correctly than classical
+	// Golub-Reinsch bidiagonalization, at the cost of slower convergence on
+	// large matrices; adequate for the moderate sizes this package targets.
+	work := a.clone()
+	v = Identity(n)
+
+	const maxSweeps = 60
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		converged := true
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma float64
+				for i := 0; i < m; i++ {
+					alpha += work[i][p] * work[i][p]
+					beta += work[i][q] * work[i][q]
+					gamma += work[i][p] * work[i][q]
+				}
+				if stdmath.Abs(gamma) <= 1e-15*stdmath.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+				zeta := (beta - alpha) / (2 * gamma)
+				t := stdmath.Copysign(1, zeta) / (stdmath.Abs(zeta) + stdmath.Sqrt(1+zeta*zeta))
+				c := 1 / stdmath.Sqrt(1+t*t)
+				sn := c * t
+				for i := 0; i < m; i++ {
+					wp, wq := work[i][p], work[i][q]
+					work[i][p] = c*wp - sn*wq
+					work[i][q] = sn*wp + c*wq
+				}
+				for i := 0; i < n; i++ {
+					vp, vq := v[i][p], v[i][q]
+					v[i][p] = c*vp - sn*vq
+					v[i][q] = sn*vp + c*vq
+				}
+			}
+		}
+		if converged {
+			break
+		}
+	}
+
+	singular := make([]float64, n)
+	u = NewMatrix(m, n)
+	for j := 0; j < n; j++ {
+		var norm float64
+		for i := 0; i < m; i++ {
+			norm += work[i][j] * work[i][j]
+		}
+		norm = stdmath.Sqrt(norm)
+		singular[j] = norm
+		if norm > 1e-300 {
+			for i := 0; i < m; i++ {
+				u[i][j] = work[i][j] / norm
+			}
+		}
+	}
+
+	// Sort singular values (and corresponding columns of U and V) descending.
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	for i := 0; i < n; i++ {
+		maxI := i
+		for j := i + 1; j < n; j++ {
+			if singular[idx[j]] > singular[idx[maxI]] {
+				maxI = j
+			}
+		}
+		idx[i], idx[maxI] = idx[maxI], idx[i]
+	}
+
+	s = NewMatrix(n, n)
+	uSorted := NewMatrix(m, n)
+	vSorted := NewMatrix(n, n)
+	for newCol, oldCol := range idx {
+		s[newCol][newCol] = singular[oldCol]
+		for i := 0; i < m; i++ {
+			uSorted[i][newCol] = u[i][oldCol]
+		}
+		for i := 0; i < n; i++ {
+			vSorted[i][newCol] = v[i][oldCol]
+		}
+	}
+	return uSorted, s, vSorted, nil
+}
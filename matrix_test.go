@@ -0,0 +1,272 @@
+package math
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func matricesApproxEqual(a, b Matrix, tol float64) bool {
+	if !sameShape(a, b) {
+		return false
+	}
+	for i := range a {
+		for j := range a[i] {
+			if !approxEqual(a[i][j], b[i][j], tol) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestConstructors(t *testing.T) {
+	if got := Zeros(2, 3); got.Rows() != 2 || got.Cols() != 3 {
+		t.Fatalf("Zeros(2,3) shape = %dx%d, want 2x3", got.Rows(), got.Cols())
+	}
+	ones := Ones(2, 2)
+	want := FromRows([]float64{1, 1}, []float64{1, 1})
+	if !matricesApproxEqual(ones, want, 0) {
+		t.Fatalf("Ones(2,2) = %v, want %v", ones, want)
+	}
+	id := Identity(3)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if id[i][j] != want {
+				t.Fatalf("Identity(3)[%d][%d] = %v, want %v", i, j, id[i][j], want)
+			}
+		}
+	}
+	diag := Diag(1, 2, 3)
+	if diag[0][0] != 1 || diag[1][1] != 2 || diag[2][2] != 3 || diag[0][1] != 0 {
+		t.Fatalf("Diag(1,2,3) = %v", diag)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	m := FromRows([]float64{1, 2, 3}, []float64{4, 5, 6})
+	want := FromRows([]float64{1, 4}, []float64{2, 5}, []float64{3, 6})
+	if got := m.Transpose(); !matricesApproxEqual(got, want, 0) {
+		t.Fatalf("Transpose() = %v, want %v", got, want)
+	}
+}
+
+func TestReshape(t *testing.T) {
+	m := FromRows([]float64{1, 2, 3}, []float64{4, 5, 6})
+	got, err := m.Reshape(3, 2)
+	if err != nil {
+		t.Fatalf("Reshape returned error: %v", err)
+	}
+	want := FromRows([]float64{1, 2}, []float64{3, 4}, []float64{5, 6})
+	if !matricesApproxEqual(got, want, 0) {
+		t.Fatalf("Reshape(3,2) = %v, want %v", got, want)
+	}
+	if _, err := m.Reshape(4, 4); err != ErrReshape {
+		t.Fatalf("Reshape(4,4) error = %v, want ErrReshape", err)
+	}
+}
+
+func TestElementwiseOps(t *testing.T) {
+	a := FromRows([]float64{1, 2}, []float64{3, 4})
+	b := FromRows([]float64{5, 6}, []float64{7, 8})
+
+	sum, err := AddM(a, b)
+	if err != nil || !matricesApproxEqual(sum, FromRows([]float64{6, 8}, []float64{10, 12}), 0) {
+		t.Fatalf("AddM = %v, %v", sum, err)
+	}
+	diff, err := SubM(b, a)
+	if err != nil || !matricesApproxEqual(diff, FromRows([]float64{4, 4}, []float64{4, 4}), 0) {
+		t.Fatalf("SubM = %v, %v", diff, err)
+	}
+	had, err := HadamardM(a, b)
+	if err != nil || !matricesApproxEqual(had, FromRows([]float64{5, 12}, []float64{21, 32}), 0) {
+		t.Fatalf("HadamardM = %v, %v", had, err)
+	}
+	scaled := ScaleM(a, 2)
+	if !matricesApproxEqual(scaled, FromRows([]float64{2, 4}, []float64{6, 8}), 0) {
+		t.Fatalf("ScaleM = %v", scaled)
+	}
+
+	mismatched := FromRows([]float64{1, 2, 3})
+	if _, err := AddM(a, mismatched); err != ErrDimensionMismatch {
+		t.Fatalf("AddM shape mismatch error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestMulM(t *testing.T) {
+	a := FromRows([]float64{1, 2}, []float64{3, 4})
+	b := FromRows([]float64{5, 6}, []float64{7, 8})
+	got, err := MulM(a, b)
+	if err != nil {
+		t.Fatalf("MulM error: %v", err)
+	}
+	want := FromRows([]float64{19, 22}, []float64{43, 50})
+	if !matricesApproxEqual(got, want, 1e-9) {
+		t.Fatalf("MulM = %v, want %v", got, want)
+	}
+	if _, err := MulM(a, FromRows([]float64{1, 2, 3})); err != ErrDimensionMismatch {
+		t.Fatalf("MulM shape mismatch error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestTraceAndNorms(t *testing.T) {
+	m := FromRows([]float64{1, 2}, []float64{3, 4})
+	tr, err := Trace(m)
+	if err != nil || tr != 5 {
+		t.Fatalf("Trace = %v, %v, want 5", tr, err)
+	}
+	if got := FrobeniusNorm(m); !approxEqual(got, math.Sqrt(1+4+9+16), 1e-9) {
+		t.Fatalf("FrobeniusNorm = %v", got)
+	}
+	if got := InfNorm(m); got != 7 {
+		t.Fatalf("InfNorm = %v, want 7", got)
+	}
+}
+
+func TestLUSolveInverseDeterminant(t *testing.T) {
+	a := FromRows([]float64{4, 3}, []float64{6, 3})
+	l, u, p, err := LU(a)
+	if err != nil {
+		t.Fatalf("LU error: %v", err)
+	}
+	pa, err := MulM(p, a)
+	if err != nil {
+		t.Fatalf("MulM(p, a) error: %v", err)
+	}
+	lu, err := MulM(l, u)
+	if err != nil {
+		t.Fatalf("MulM(l, u) error: %v", err)
+	}
+	if !matricesApproxEqual(pa, lu, 1e-9) {
+		t.Fatalf("P*A = %v, want L*U = %v", pa, lu)
+	}
+
+	det, err := Determinant(a)
+	if err != nil || !approxEqual(det, -6, 1e-9) {
+		t.Fatalf("Determinant = %v, %v, want -6", det, err)
+	}
+
+	inv, err := Inverse(a)
+	if err != nil {
+		t.Fatalf("Inverse error: %v", err)
+	}
+	prod, err := MulM(a, inv)
+	if err != nil || !matricesApproxEqual(prod, Identity(2), 1e-9) {
+		t.Fatalf("A*Inverse(A) = %v, %v, want identity", prod, err)
+	}
+
+	b := FromRows([]float64{1}, []float64{2})
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("Solve error: %v", err)
+	}
+	check, err := MulM(a, x)
+	if err != nil || !matricesApproxEqual(check, b, 1e-9) {
+		t.Fatalf("A*Solve(A,b) = %v, %v, want %v", check, err, b)
+	}
+
+	singular := FromRows([]float64{1, 2}, []float64{2, 4})
+	if _, err := Inverse(singular); err != ErrSingularMatrix {
+		t.Fatalf("Inverse(singular) error = %v, want ErrSingularMatrix", err)
+	}
+}
+
+func TestQR(t *testing.T) {
+	a := FromRows([]float64{1, -1}, []float64{1, 1}, []float64{0, 1})
+	q, r, err := QR(a)
+	if err != nil {
+		t.Fatalf("QR error: %v", err)
+	}
+	reconstructed, err := MulM(q, r)
+	if err != nil || !matricesApproxEqual(reconstructed, a, 1e-9) {
+		t.Fatalf("Q*R = %v, %v, want %v", reconstructed, err, a)
+	}
+	qtq, err := MulM(q.Transpose(), q)
+	if err != nil || !matricesApproxEqual(qtq, Identity(3), 1e-9) {
+		t.Fatalf("Q^T*Q = %v, %v, want identity", qtq, err)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	a := FromRows([]float64{4, 12, -16}, []float64{12, 37, -43}, []float64{-16, -43, 98})
+	l, err := Cholesky(a)
+	if err != nil {
+		t.Fatalf("Cholesky error: %v", err)
+	}
+	reconstructed, err := MulM(l, l.Transpose())
+	if err != nil || !matricesApproxEqual(reconstructed, a, 1e-9) {
+		t.Fatalf("L*L^T = %v, %v, want %v", reconstructed, err, a)
+	}
+
+	notSPD := FromRows([]float64{1, 2}, []float64{2, 1})
+	if _, err := Cholesky(notSPD); err != ErrNotSPD {
+		t.Fatalf("Cholesky(not SPD) error = %v, want ErrNotSPD", err)
+	}
+}
+
+func TestSVD(t *testing.T) {
+	a := FromRows([]float64{3, 0}, []float64{0, -2})
+	u, s, v, err := SVD(a)
+	if err != nil {
+		t.Fatalf("SVD error: %v", err)
+	}
+	us, err := MulM(u, s)
+	if err != nil {
+		t.Fatalf("MulM(u, s) error: %v", err)
+	}
+	reconstructed, err := MulM(us, v.Transpose())
+	if err != nil || !matricesApproxEqual(reconstructed, a, 1e-9) {
+		t.Fatalf("U*S*V^T = %v, %v, want %v", reconstructed, err, a)
+	}
+	if s[0][0] < s[1][1] {
+		t.Fatalf("singular values not sorted descending: %v", s)
+	}
+}
+
+func BenchmarkMulM(b *testing.B) {
+	x := Identity(32)
+	y := Identity(32)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := MulM(x, y); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLU(b *testing.B) {
+	a := FromRows([]float64{4, 3, 2}, []float64{6, 3, 5}, []float64{2, 8, 1})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, err := LU(a); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDeterminant(b *testing.B) {
+	a := FromRows([]float64{4, 3, 2}, []float64{6, 3, 5}, []float64{2, 8, 1})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Determinant(a); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSVD(b *testing.B) {
+	a := FromRows([]float64{3, 0}, []float64{0, -2})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, err := SVD(a); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
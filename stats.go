@@ -0,0 +1,272 @@
+package math
+
+import (
+	"errors"
+	stdmath "math"
+	"sort"
+)
+
+// ErrLengthMismatch is returned by statistics functions that require two
+// equally sized samples.
+var ErrLengthMismatch = errors.New("math: sample lengths do not match")
+
+// Mean returns the arithmetic mean of x.
+func Mean[N Number](x ...N) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	return Sum(x...) / float64(len(x))
+}
+
+// GeoMean returns the geometric mean of x.
+func GeoMean[N Number](x ...N) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	var sumLog float64
+	for _, v := range x {
+		sumLog += stdmath.Log(float64(v))
+	}
+	return stdmath.Exp(sumLog / float64(len(x)))
+}
+
+// HarmonicMean returns the harmonic mean of x.
+func HarmonicMean[N Number](x ...N) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	var sumInv float64
+	for _, v := range x {
+		sumInv += 1 / float64(v)
+	}
+	return float64(len(x)) / sumInv
+}
+
+// variance returns the sum of squared deviations from the mean of x, along
+// with the mean itself, for reuse by the sample and population variants.
+func variance[N Number](x []N) (sumSq, mean float64) {
+	mean = Mean(x...)
+	for _, v := range x {
+		d := float64(v) - mean
+		sumSq += d * d
+	}
+	return sumSq, mean
+}
+
+// Variance returns the sample variance of x (denominator n-1).
+// It returns 0 for fewer than two values.
+func Variance[N Number](x ...N) float64 {
+	if len(x) < 2 {
+		return 0
+	}
+	sumSq, _ := variance(x)
+	return sumSq / float64(len(x)-1)
+}
+
+// PopulationVariance returns the population variance of x (denominator n).
+func PopulationVariance[N Number](x ...N) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	sumSq, _ := variance(x)
+	return sumSq / float64(len(x))
+}
+
+// StdDev returns the sample standard deviation of x.
+func StdDev[N Number](x ...N) float64 {
+	return stdmath.Sqrt(Variance(x...))
+}
+
+// PopulationStdDev returns the population standard deviation of x.
+func PopulationStdDev[N Number](x ...N) float64 {
+	return stdmath.Sqrt(PopulationVariance(x...))
+}
+
+// sortedFloats returns a sorted copy of x as float64.
+func sortedFloats[N Number](x []N) []float64 {
+	out := make([]float64, len(x))
+	for i, v := range x {
+		out[i] = float64(v)
+	}
+	sort.Float64s(out)
+	return out
+}
+
+// Median returns the median of x.
+func Median[N Number](x ...N) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	s := sortedFloats(x)
+	mid := len(s) / 2
+	if len(s)%2 == 1 {
+		return s[mid]
+	}
+	return (s[mid-1] + s[mid]) / 2
+}
+
+// Quantile returns the value below which a proportion p (in [0,1]) of the
+// values in x fall, using linear interpolation between closest ranks.
+func Quantile[N Number](x []N, p float64) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	p = Clamp(p, 0, 1)
+	s := sortedFloats(x)
+	if len(s) == 1 {
+		return s[0]
+	}
+	pos := p * float64(len(s)-1)
+	lo := int(stdmath.Floor(pos))
+	hi := int(stdmath.Ceil(pos))
+	if lo == hi {
+		return s[lo]
+	}
+	frac := pos - float64(lo)
+	return Lerp(s[lo], s[hi], frac)
+}
+
+// Percentile returns the value below which a percentage p (in [0,100]) of
+// the values in x fall. It is equivalent to Quantile(x, p/100).
+func Percentile[N Number](x []N, p float64) float64 {
+	return Quantile(x, p/100)
+}
+
+// Mode returns the most frequently occurring value in x. Ties are broken in
+// favor of the value that occurs first in x.
+func Mode[N Number](x ...N) N {
+	var mode N
+	counts := make(map[N]int, len(x))
+	for _, v := range x {
+		counts[v]++
+	}
+	best := 0
+	for _, v := range x {
+		if counts[v] > best {
+			best = counts[v]
+			mode = v
+		}
+	}
+	return mode
+}
+
+// Covariance returns the sample covariance between x and y.
+func Covariance[N Number](x, y []N) (float64, error) {
+	if len(x) != len(y) {
+		return 0, ErrLengthMismatch
+	}
+	if len(x) < 2 {
+		return 0, nil
+	}
+	mx, my := Mean(x...), Mean(y...)
+	var sum float64
+	for i := range x {
+		sum += (float64(x[i]) - mx) * (float64(y[i]) - my)
+	}
+	return sum / float64(len(x)-1), nil
+}
+
+// PearsonCorrelation returns the Pearson correlation coefficient between x
+// and y, in [-1, 1].
+func PearsonCorrelation[N Number](x, y []N) (float64, error) {
+	cov, err := Covariance(x, y)
+	if err != nil {
+		return 0, err
+	}
+	sx, sy := StdDev(x...), StdDev(y...)
+	if sx == 0 || sy == 0 {
+		return 0, nil
+	}
+	return cov / (sx * sy), nil
+}
+
+// Skewness returns the population skewness of x (the third standardized
+// moment, via PopulationStdDev), a measure of distribution asymmetry.
+func Skewness[N Number](x ...N) float64 {
+	n := len(x)
+	if n < 2 {
+		return 0
+	}
+	mean := Mean(x...)
+	sd := PopulationStdDev(x...)
+	if sd == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range x {
+		sum += stdmath.Pow((float64(v)-mean)/sd, 3)
+	}
+	return sum / float64(n)
+}
+
+// Kurtosis returns the population excess kurtosis of x (the fourth
+// standardized moment, via PopulationStdDev, minus 3; normal distribution = 0).
+func Kurtosis[N Number](x ...N) float64 {
+	n := len(x)
+	if n < 2 {
+		return 0
+	}
+	mean := Mean(x...)
+	sd := PopulationStdDev(x...)
+	if sd == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range x {
+		sum += stdmath.Pow((float64(v)-mean)/sd, 4)
+	}
+	return sum/float64(n) - 3
+}
+
+// Welford is a streaming accumulator implementing Welford's algorithm for
+// numerically stable, single-pass mean and variance estimation.
+type Welford[N Number] struct {
+	count int64
+	mean  float64
+	m2    float64
+}
+
+// Push folds x into the accumulator.
+func (w *Welford[N]) Push(x N) {
+	w.count++
+	d := float64(x) - w.mean
+	w.mean += d / float64(w.count)
+	d2 := float64(x) - w.mean
+	w.m2 += d * d2
+}
+
+// Count returns the number of values pushed so far.
+func (w *Welford[N]) Count() int64 {
+	return w.count
+}
+
+// Mean returns the running mean.
+func (w *Welford[N]) Mean() float64 {
+	return w.mean
+}
+
+// Variance returns the running sample variance (denominator n-1).
+func (w *Welford[N]) Variance() float64 {
+	if w.count < 2 {
+		return 0
+	}
+	return w.m2 / float64(w.count-1)
+}
+
+// Merge combines other into w using Chan/Welford's numerically stable
+// parallel variance combination, as if every value pushed to other had been
+// pushed to w directly.
+func (w *Welford[N]) Merge(other *Welford[N]) {
+	if other.count == 0 {
+		return
+	}
+	if w.count == 0 {
+		w.count, w.mean, w.m2 = other.count, other.mean, other.m2
+		return
+	}
+	n := w.count + other.count
+	d := other.mean - w.mean
+	mean := w.mean + d*float64(other.count)/float64(n)
+	m2 := w.m2 + other.m2 + d*d*float64(w.count)*float64(other.count)/float64(n)
+	w.count, w.mean, w.m2 = n, mean, m2
+}
@@ -0,0 +1,67 @@
+package math
+
+import "testing"
+
+func TestWelfordMatchesDirectMeanVariance(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	var w Welford[float64]
+	for _, v := range data {
+		w.Push(v)
+	}
+	if got, want := w.Mean(), Mean(data...); !approxEqual(got, want, 1e-9) {
+		t.Fatalf("Welford.Mean() = %v, want %v", got, want)
+	}
+	if got, want := w.Variance(), Variance(data...); !approxEqual(got, want, 1e-9) {
+		t.Fatalf("Welford.Variance() = %v, want %v", got, want)
+	}
+}
+
+func TestWelfordMerge(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	var whole Welford[float64]
+	for _, v := range data {
+		whole.Push(v)
+	}
+
+	var left, right Welford[float64]
+	for _, v := range data[:3] {
+		left.Push(v)
+	}
+	for _, v := range data[3:] {
+		right.Push(v)
+	}
+	left.Merge(&right)
+
+	if got, want := left.Mean(), whole.Mean(); !approxEqual(got, want, 1e-9) {
+		t.Fatalf("merged Mean() = %v, want %v", got, want)
+	}
+	if got, want := left.Variance(), whole.Variance(); !approxEqual(got, want, 1e-9) {
+		t.Fatalf("merged Variance() = %v, want %v", got, want)
+	}
+	if got, want := left.Count(), whole.Count(); got != want {
+		t.Fatalf("merged Count() = %v, want %v", got, want)
+	}
+}
+
+func TestWelfordMergeEmptyOther(t *testing.T) {
+	var w Welford[float64]
+	w.Push(1)
+	w.Push(2)
+	var empty Welford[float64]
+	w.Merge(&empty)
+	if w.Count() != 2 || !approxEqual(w.Mean(), 1.5, 1e-9) {
+		t.Fatalf("Merge with empty accumulator changed state: count=%d mean=%v", w.Count(), w.Mean())
+	}
+}
+
+func TestWelfordMergeIntoEmpty(t *testing.T) {
+	var empty Welford[float64]
+	var other Welford[float64]
+	other.Push(3)
+	other.Push(5)
+	empty.Merge(&other)
+	if empty.Count() != 2 || !approxEqual(empty.Mean(), 4, 1e-9) {
+		t.Fatalf("Merge into empty accumulator = count=%d mean=%v, want count=2 mean=4", empty.Count(), empty.Mean())
+	}
+}
@@ -23,17 +23,12 @@ func Lerp[N Number](a, b N, t float64) N {
 	return N(float64(a) + t*(float64(b)-float64(a)))
 }
 
-// Avg calculates the average of a variadic number of values.
+// Avg calculates the arithmetic mean of a variadic number of values.
 func Avg[N Number](x ...N) N {
-	res := 0.0
-	for i, n := range x {
-		if i == 0 {
-			res = float64(n)
-			continue
-		}
-		res = (res + float64(n)) / 2.0
+	if len(x) == 0 {
+		return 0
 	}
-	return N(res)
+	return N(Sum(x...) / float64(len(x)))
 }
 
 // Clamp restricts x to be within the range [min, max].
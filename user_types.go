@@ -12,5 +12,16 @@ type Float interface {
 	float32 | float64
 }
 
+// Complex is a constraint for complex types.
+type Complex interface {
+	complex64 | complex128
+}
+
+// BitsUint is a constraint covering the unsigned integer widths supported by
+// the math/bits package.
+type BitsUint interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+
 // Matrix represents a two-dimensional slice of float64 values.
 type Matrix [][]float64